@@ -3,6 +3,7 @@ package iso8601
 import (
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -133,6 +134,61 @@ func (d Duration) MustTimeDuration() time.Duration {
 	return ret
 }
 
+// AddTo applies this duration to t using calendar semantics: Years and
+// Months are added on the calendar with the day clamped to the target
+// month's length, Weeks and Days are added via time.AddDate, so month/year
+// lengths and leap days are respected; the remaining fields are added as
+// plain nanoseconds.
+// Unlike TimeDuration, this does not lose precision on a "P1M"-style
+// duration (e.g. adding it to Jan 31 gives Feb 28/29, not a fixed number of
+// nanoseconds).
+func (d Duration) AddTo(t time.Time) time.Time {
+	return d.AddToInLocation(t, t.Location())
+}
+
+// AddToInLocation is like AddTo, but the Years/Months/Weeks/Days fields are
+// added in loc, so DST transitions crossed by those fields follow loc's
+// rules rather than t's. The result is converted back to t's location.
+func (d Duration) AddToInLocation(t time.Time, loc *time.Location) time.Time {
+	var sign int64 = 1
+	if d.Negative {
+		sign = -1
+	}
+	var orig = t.Location()
+	var local = t.In(loc)
+	var y, mo, day = local.Date()
+	var hh, mm, ss = local.Clock()
+	var nsec = local.Nanosecond()
+
+	// Add Years/Months first, clamping the day to the target month's
+	// length: time.Date would otherwise normalize an out-of-range day by
+	// rolling into the following month (Jan 31 + P1M would land on Mar 2
+	// instead of Feb 29).
+	var withMonths = time.Date(y+int(sign*d.Years), mo+time.Month(sign*d.Months), 1, hh, mm, ss, nsec, loc)
+	var ty, tm, _ = withMonths.Date()
+	var lastDay = time.Date(ty, tm+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	var withDate = time.Date(ty, tm, day, hh, mm, ss, nsec, loc)
+
+	// Weeks/Days are plain calendar-day arithmetic, which doesn't need
+	// clamping.
+	var ret = withDate.AddDate(0, 0, int(sign*(d.Weeks*7+d.Days))).In(orig)
+	var nano = sign * (d.Hours*int64(time.Hour) +
+		d.Minutes*int64(time.Minute) +
+		d.Seconds*int64(time.Second) +
+		d.Nanoseconds)
+	return ret.Add(time.Duration(nano))
+}
+
+// SubtractFrom is like AddTo but subtracts the duration from t instead.
+func (d Duration) SubtractFrom(t time.Time) time.Time {
+	var negated = d
+	negated.Negative = !negated.Negative
+	return negated.AddTo(t)
+}
+
 // appendFrac append the fraction of v/10**prec (e.g., ".12345") into the
 // tail of buf, omitting trailing zeros. It omits the decimal
 // point too when the fraction is 0. It returns the index where the
@@ -247,6 +303,77 @@ func (d Duration) String() string {
 	return string(d.AppendFormat(make([]byte, 0, 256)))
 }
 
+// appendZeroPad appends v to b in decimal, left-padding its digits with
+// zeros to at least width digits; a negative v gets a '-' before the padding.
+func appendZeroPad(b []byte, v int64, width int) []byte {
+	if v < 0 {
+		b = append(b, '-')
+		v = -v
+	}
+	var buf [20]byte
+	var s = strconv.AppendInt(buf[:0], v, 10)
+	for i := len(s); i < width; i++ {
+		b = append(b, '0')
+	}
+	return append(b, s...)
+}
+
+// ErrAltFormatOverflow indicates a Duration field does not fit the
+// calendar-valid range the ISO 8601 §5.5.4.2 alternative format requires
+// (Years >9999, Months >11, Weeks*7+Days >30, Hours >23, Minutes/Seconds
+// >59), so AppendFormatAlt cannot produce a string ParseDuration could
+// read back.
+var ErrAltFormatOverflow = errors.New("iso8601: duration field out of range for alternative format")
+
+// AppendFormatAlt is like AppendFormat, but produces the ISO 8601 §5.5.4.2
+// alternative format (e.g. "P0003-06-04T12:30:05") instead of the
+// designator form. Since that format has no week field, Weeks is folded
+// into Days. Its fields use calendar-valid ranges (e.g. Months 0-11, Days
+// 0-30) rather than the full 2-digit range, matching what parseAltDuration
+// accepts, so the format always round-trips; it returns
+// ErrAltFormatOverflow, leaving b unchanged, if a field is out of range.
+func (d Duration) AppendFormatAlt(b []byte) ([]byte, error) {
+	var days = d.Weeks*7 + d.Days
+	if d.Years < 0 || d.Years > 9999 ||
+		d.Months < 0 || d.Months >= 12 ||
+		days < 0 || days >= 31 ||
+		d.Hours < 0 || d.Hours >= 24 ||
+		d.Minutes < 0 || d.Minutes >= 60 ||
+		d.Seconds < 0 || d.Seconds >= 60 ||
+		d.Nanoseconds < 0 {
+		return b, ErrAltFormatOverflow
+	}
+
+	if d.Negative {
+		b = append(b, '-')
+	}
+	b = append(b, 'P')
+	b = appendZeroPad(b, d.Years, 4)
+	b = append(b, '-')
+	b = appendZeroPad(b, d.Months, 2)
+	b = append(b, '-')
+	b = appendZeroPad(b, days, 2)
+	b = append(b, 'T')
+	b = appendZeroPad(b, d.Hours, 2)
+	b = append(b, ':')
+	b = appendZeroPad(b, d.Minutes, 2)
+	b = append(b, ':')
+	b = appendZeroPad(b, d.Seconds, 2)
+	b = appendFrac(b, uint64(d.Nanoseconds), 9)
+	return b, nil
+}
+
+// StringAlt is like String, but renders the ISO 8601 §5.5.4.2 alternative
+// format via AppendFormatAlt. It returns ErrAltFormatOverflow if a field
+// does not fit that format's fixed width.
+func (d Duration) StringAlt() (string, error) {
+	var b, err = d.AppendFormatAlt(make([]byte, 0, 32))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 // NewDuration create duration from nanoseconds (e.g. time.Duration)
 // Only use unit that below days, because days can have different length (e.g. DST).
 // just declaring a Duration variable is enough to use duration.
@@ -341,6 +468,111 @@ func (err ErrInvalidDuration) Error() string {
 	return "iso8601: invalid duration " + err.String
 }
 
+// isAltDurationBody reports whether s (the content following "P") looks
+// like the ISO 8601 §5.5.4.2 alternative format: a run of digits and '-'
+// up to an optional "T" that either contains a '-' (extended form, e.g.
+// "0003-06-04") or is exactly 8 digits (basic form, e.g. "00030604").
+func isAltDurationBody(s string) bool {
+	var dash bool
+	var i int
+	for ; i < len(s) && s[i] != 'T'; i++ {
+		switch {
+		case s[i] == '-':
+			dash = true
+		case s[i] < '0' || s[i] > '9':
+			return false
+		}
+	}
+	return dash || i == 8
+}
+
+// parseAltDuration parses the ISO 8601 §5.5.4.2 alternative fixed-width
+// duration format, e.g. "0003-06-04T12:30:05" or "00030604T123005" (s is
+// already stripped of its leading "P" and sign).
+func parseAltDuration(orig, s string) (ret Duration, err error) {
+	var datePart, timePart string
+	var hasTime bool
+	if i := strings.IndexByte(s, 'T'); i >= 0 {
+		datePart, timePart, hasTime = s[:i], s[i+1:], true
+	} else {
+		datePart = s
+	}
+
+	var y, mo, da int64
+	if strings.Contains(datePart, "-") {
+		var fields = strings.Split(datePart, "-")
+		if len(fields) != 3 {
+			return ret, ErrInvalidDuration{String: orig}
+		}
+		y, err = strconv.ParseInt(fields[0], 10, 64)
+		if err == nil {
+			mo, err = strconv.ParseInt(fields[1], 10, 64)
+		}
+		if err == nil {
+			da, err = strconv.ParseInt(fields[2], 10, 64)
+		}
+	} else if len(datePart) == 8 {
+		y, err = strconv.ParseInt(datePart[0:4], 10, 64)
+		if err == nil {
+			mo, err = strconv.ParseInt(datePart[4:6], 10, 64)
+		}
+		if err == nil {
+			da, err = strconv.ParseInt(datePart[6:8], 10, 64)
+		}
+	} else {
+		err = ErrInvalidDuration{String: orig}
+	}
+	if err != nil || mo < 0 || mo >= 12 || da < 0 || da >= 31 {
+		return ret, ErrInvalidDuration{String: orig}
+	}
+	ret.Years, ret.Months, ret.Days = y, mo, da
+
+	if hasTime {
+		// Seconds may carry a decimal fraction, as AppendFormatAlt writes it.
+		var frac string
+		var hasFrac bool
+		if j := strings.IndexByte(timePart, '.'); j >= 0 {
+			timePart, frac, hasFrac = timePart[:j], timePart[j+1:], true
+		}
+		var h, mi, se int64
+		if strings.Contains(timePart, ":") {
+			var fields = strings.Split(timePart, ":")
+			if len(fields) != 3 {
+				return ret, ErrInvalidDuration{String: orig}
+			}
+			h, err = strconv.ParseInt(fields[0], 10, 64)
+			if err == nil {
+				mi, err = strconv.ParseInt(fields[1], 10, 64)
+			}
+			if err == nil {
+				se, err = strconv.ParseInt(fields[2], 10, 64)
+			}
+		} else if len(timePart) == 6 {
+			h, err = strconv.ParseInt(timePart[0:2], 10, 64)
+			if err == nil {
+				mi, err = strconv.ParseInt(timePart[2:4], 10, 64)
+			}
+			if err == nil {
+				se, err = strconv.ParseInt(timePart[4:6], 10, 64)
+			}
+		} else {
+			err = ErrInvalidDuration{String: orig}
+		}
+		if err != nil || h < 0 || h >= 24 || mi < 0 || mi >= 60 || se < 0 || se >= 60 {
+			return ret, ErrInvalidDuration{String: orig}
+		}
+		ret.Hours, ret.Minutes, ret.Seconds = h, mi, se
+		if hasFrac {
+			var f, scale, rem = leadingFraction(frac)
+			if frac == "" || rem != "" {
+				return ret, ErrInvalidDuration{String: orig}
+			}
+			ret.Nanoseconds = int64(float64(f) * (float64(time.Second/time.Nanosecond) / scale))
+		}
+	}
+	return ret, nil
+}
+
 // ParseDuration parse iso8601 duration string.
 func ParseDuration(s string) (ret Duration, err error) {
 	orig := s
@@ -352,6 +584,13 @@ func ParseDuration(s string) (ret Duration, err error) {
 	}
 	s = s[1:]
 
+	if isAltDurationBody(s) {
+		var negative = ret.Negative
+		ret, err = parseAltDuration(orig, s)
+		ret.Negative = negative
+		return
+	}
+
 	var afterT bool
 	for s != "" {
 		if s[0] == 'T' {
@@ -0,0 +1,45 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	var ret, err = time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatalf("time.Parse(%q): %v", s, err)
+	}
+	return ret
+}
+
+func TestDurationAddTo(t *testing.T) {
+	var jan31 = mustParseTime(t, "2024-01-31T00:00:00Z")
+	var d = Duration{Months: 1}
+	var got = d.AddTo(jan31)
+	var want = mustParseTime(t, "2024-02-29T00:00:00Z") // 2024 is a leap year
+	if !got.Equal(want) {
+		t.Errorf("AddTo(%v) = %v, want %v", jan31, got, want)
+	}
+}
+
+func TestDurationSubtractFrom(t *testing.T) {
+	var mar1 = mustParseTime(t, "2024-03-01T00:00:00Z")
+	var d = Duration{Days: 1}
+	var got = d.SubtractFrom(mar1)
+	var want = mustParseTime(t, "2024-02-29T00:00:00Z")
+	if !got.Equal(want) {
+		t.Errorf("SubtractFrom(%v) = %v, want %v", mar1, got, want)
+	}
+}
+
+func TestDurationAddToNegative(t *testing.T) {
+	var t0 = mustParseTime(t, "2024-01-01T00:00:00Z")
+	var d = Duration{Days: 1, Negative: true}
+	var got = d.AddTo(t0)
+	var want = mustParseTime(t, "2023-12-31T00:00:00Z")
+	if !got.Equal(want) {
+		t.Errorf("AddTo(%v) = %v, want %v", t0, got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package iso8601
+
+import "testing"
+
+func TestParseDurationAlt(t *testing.T) {
+	var cases = []struct {
+		s    string
+		want Duration
+	}{
+		{"P0003-06-04T12:30:05", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}},
+		{"P00030604T123005", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}},
+		{"P0001-00-00", Duration{Years: 1}},
+	}
+	for _, c := range cases {
+		var got, err = ParseDuration(c.s)
+		if err != nil {
+			t.Fatalf("ParseDuration(%q) error: %v", c.s, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseDuration(%q) = %+v, want %+v", c.s, got, c.want)
+		}
+	}
+}
+
+func TestDurationStringAltRoundTrip(t *testing.T) {
+	var d = Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}
+	var s, err = d.StringAlt()
+	if err != nil {
+		t.Fatalf("StringAlt() error: %v", err)
+	}
+	var got, parseErr = ParseDuration(s)
+	if parseErr != nil {
+		t.Fatalf("ParseDuration(%q) error: %v", s, parseErr)
+	}
+	if got != d {
+		t.Errorf("round trip = %+v, want %+v", got, d)
+	}
+}
+
+func TestDurationStringAltOverflow(t *testing.T) {
+	var cases = []Duration{
+		{Weeks: 20, Days: 3}, // Weeks*7+Days = 143, doesn't fit 2 digits
+		{Years: 10000},
+		{Months: 100},
+		{Hours: 100},
+		{Minutes: 100},
+		{Seconds: 100},
+		{Months: 15}, // fits 2 digits but isn't calendar-valid (>11)
+		{Days: 45},   // fits 2 digits but isn't calendar-valid (>30)
+		{Hours: 30},
+		{Minutes: 70},
+		{Seconds: 70},
+	}
+	for _, d := range cases {
+		if _, err := d.StringAlt(); err != ErrAltFormatOverflow {
+			t.Errorf("StringAlt() for %+v = error %v, want ErrAltFormatOverflow", d, err)
+		}
+	}
+}
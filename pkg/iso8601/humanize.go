@@ -0,0 +1,222 @@
+package iso8601
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// UnitStyle controls how unit names are rendered by Humanize.
+type UnitStyle int
+
+const (
+	// UnitStyleLong renders names like "year", "month" (pluralized).
+	UnitStyleLong UnitStyle = iota
+	// UnitStyleShort renders abbreviations like "yr", "mo".
+	UnitStyleShort
+	// UnitStyleNarrow renders the shortest units like "y", "mo", with no
+	// separating space.
+	UnitStyleNarrow
+)
+
+// humanizeOptions holds the resolved settings from HumanizeOption values.
+type humanizeOptions struct {
+	locale   language.Tag
+	maxUnits int
+	style    UnitStyle
+}
+
+// HumanizeOption configures Duration.Humanize.
+type HumanizeOption func(*humanizeOptions)
+
+// WithLocale selects the language unit names are rendered in. Unrecognized
+// locales fall back to English.
+func WithLocale(tag language.Tag) HumanizeOption {
+	return func(o *humanizeOptions) { o.locale = tag }
+}
+
+// WithMaxUnits caps the number of units Humanize includes, largest first.
+// n <= 0 means unlimited.
+func WithMaxUnits(n int) HumanizeOption {
+	return func(o *humanizeOptions) { o.maxUnits = n }
+}
+
+// WithUnitStyle selects long, short or narrow unit names.
+func WithUnitStyle(style UnitStyle) HumanizeOption {
+	return func(o *humanizeOptions) { o.style = style }
+}
+
+// humanizeLocale is one language's unit name tables plus its spacing
+// convention: spaced puts a space between a number and its unit, and
+// between units (true for English/Spanish, false for Chinese, which
+// doesn't). longPlural holds the long-form plural of each unit, used when
+// the count isn't 1; a locale with no plural forms (e.g. Chinese) leaves
+// it empty and always uses names[UnitStyleLong].
+type humanizeLocale struct {
+	names      map[UnitStyle][7]string
+	longPlural [7]string
+	spaced     bool
+}
+
+// humanizeLocales are the fields Humanize renders, largest to smallest;
+// Nanoseconds is omitted as too fine-grained for human output. Only a
+// handful of locales ship built in; WithLocale falls back to English for
+// any base language not listed here.
+var humanizeLocales = map[string]humanizeLocale{
+	"en": {
+		names: map[UnitStyle][7]string{
+			UnitStyleLong:   {"year", "month", "week", "day", "hour", "minute", "second"},
+			UnitStyleShort:  {"yr", "mo", "wk", "d", "hr", "min", "sec"},
+			UnitStyleNarrow: {"y", "mo", "w", "d", "h", "m", "s"},
+		},
+		longPlural: [7]string{"years", "months", "weeks", "days", "hours", "minutes", "seconds"},
+		spaced:     true,
+	},
+	"es": {
+		names: map[UnitStyle][7]string{
+			UnitStyleLong:   {"año", "mes", "semana", "día", "hora", "minuto", "segundo"},
+			UnitStyleShort:  {"año", "mes", "sem", "día", "hr", "min", "seg"},
+			UnitStyleNarrow: {"a", "m", "sem", "d", "h", "m", "s"},
+		},
+		longPlural: [7]string{"años", "meses", "semanas", "días", "horas", "minutos", "segundos"},
+		spaced:     true,
+	},
+	"zh": {
+		names: map[UnitStyle][7]string{
+			UnitStyleLong:   {"年", "个月", "周", "天", "小时", "分钟", "秒"},
+			UnitStyleShort:  {"年", "月", "周", "天", "时", "分", "秒"},
+			UnitStyleNarrow: {"年", "月", "周", "天", "时", "分", "秒"},
+		},
+		spaced: false,
+	},
+}
+
+// humanizeLocaleFor resolves tag to a built-in locale, falling back to
+// English when tag's base language isn't one of humanizeLocales.
+func humanizeLocaleFor(tag language.Tag) humanizeLocale {
+	var base, _ = tag.Base()
+	if l, ok := humanizeLocales[base.String()]; ok {
+		return l
+	}
+	return humanizeLocales["en"]
+}
+
+// Humanize renders d as a human-readable string, e.g. "1 year 2 months 3
+// days", for use in CLIs and user-facing messages where String's ISO 8601
+// output is unfriendly. See WithLocale, WithMaxUnits and WithUnitStyle.
+func (d Duration) Humanize(opts ...HumanizeOption) string {
+	var o = humanizeOptions{locale: language.English, style: UnitStyleLong}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var locale = humanizeLocaleFor(o.locale)
+	var names = locale.names[o.style]
+	var values = [7]int64{d.Years, d.Months, d.Weeks, d.Days, d.Hours, d.Minutes, d.Seconds}
+
+	var format = func(i int, v int64) string {
+		var name = names[i]
+		if o.style == UnitStyleLong && v != 1 && locale.longPlural[i] != "" {
+			name = locale.longPlural[i]
+		}
+		if o.style == UnitStyleNarrow || !locale.spaced {
+			return strconv.FormatInt(v, 10) + name
+		}
+		return strconv.FormatInt(v, 10) + " " + name
+	}
+
+	var parts = make([]string, 0, len(values))
+	for i, v := range values {
+		if v == 0 {
+			continue
+		}
+		parts = append(parts, format(i, v))
+		if o.maxUnits > 0 && len(parts) == o.maxUnits {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, format(6, 0))
+	}
+
+	var sep = " "
+	if o.style == UnitStyleNarrow || !locale.spaced {
+		sep = ""
+	}
+	var s = strings.Join(parts, sep)
+	if d.Negative {
+		s = "-" + s
+	}
+	return s
+}
+
+// ErrInvalidHumanized returned when ParseHumanized fails.
+type ErrInvalidHumanized struct {
+	String string
+}
+
+func (err ErrInvalidHumanized) Error() string {
+	return "iso8601: invalid humanized duration " + err.String
+}
+
+// ParseHumanized parses the output of Humanize (in any UnitStyle, English
+// only), e.g. "1 year 2 months", "1y 2mo 3d", so config files can use
+// either that or the ISO 8601 form accepted by ParseDuration.
+func ParseHumanized(s string) (ret Duration, err error) {
+	var orig = s
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "-") {
+		ret.Negative = true
+		s = s[1:]
+	}
+
+	for s != "" {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			break
+		}
+
+		var i int
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return Duration{}, ErrInvalidHumanized{String: orig}
+		}
+		var v, convErr = strconv.ParseInt(s[:i], 10, 64)
+		if convErr != nil {
+			return Duration{}, ErrInvalidHumanized{String: orig}
+		}
+		s = strings.TrimLeft(s[i:], " ")
+
+		var j int
+		for j < len(s) && ((s[j] >= 'a' && s[j] <= 'z') || (s[j] >= 'A' && s[j] <= 'Z')) {
+			j++
+		}
+		if j == 0 {
+			return Duration{}, ErrInvalidHumanized{String: orig}
+		}
+		var unit = strings.ToLower(s[:j])
+		s = s[j:]
+
+		switch {
+		case strings.HasPrefix(unit, "y"):
+			ret.Years += v
+		case strings.HasPrefix(unit, "mo"):
+			ret.Months += v
+		case strings.HasPrefix(unit, "w"):
+			ret.Weeks += v
+		case strings.HasPrefix(unit, "d"):
+			ret.Days += v
+		case strings.HasPrefix(unit, "h"):
+			ret.Hours += v
+		case strings.HasPrefix(unit, "mi"), unit == "m":
+			ret.Minutes += v
+		case strings.HasPrefix(unit, "s"):
+			ret.Seconds += v
+		default:
+			return Duration{}, ErrInvalidHumanized{String: orig}
+		}
+	}
+	return ret, nil
+}
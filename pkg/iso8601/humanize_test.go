@@ -0,0 +1,78 @@
+package iso8601
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestDurationHumanize(t *testing.T) {
+	var cases = []struct {
+		d    Duration
+		opts []HumanizeOption
+		want string
+	}{
+		{Duration{Years: 1, Months: 2, Days: 3}, nil, "1 year 2 months 3 days"},
+		{Duration{Years: 2}, nil, "2 years"},
+		{Duration{}, nil, "0 seconds"},
+		{Duration{}, []HumanizeOption{WithUnitStyle(UnitStyleShort)}, "0 sec"},
+		{Duration{}, []HumanizeOption{WithUnitStyle(UnitStyleNarrow)}, "0s"},
+		{Duration{Years: 1, Months: 2, Negative: true}, nil, "-1 year 2 months"},
+		{Duration{Years: 1, Months: 2, Days: 3}, []HumanizeOption{WithMaxUnits(2)}, "1 year 2 months"},
+		{Duration{Years: 1, Months: 2}, []HumanizeOption{WithUnitStyle(UnitStyleShort)}, "1 yr 2 mo"},
+		{Duration{Years: 1, Months: 2}, []HumanizeOption{WithUnitStyle(UnitStyleNarrow)}, "1y2mo"},
+	}
+	for _, c := range cases {
+		var got = c.d.Humanize(c.opts...)
+		if got != c.want {
+			t.Errorf("Humanize(%+v) = %q, want %q", c.d, got, c.want)
+		}
+	}
+}
+
+func TestParseHumanized(t *testing.T) {
+	var cases = []struct {
+		s    string
+		want Duration
+	}{
+		{"1 year 2 months 3 days", Duration{Years: 1, Months: 2, Days: 3}},
+		{"1y 2mo 3d", Duration{Years: 1, Months: 2, Days: 3}},
+		{"-1 year 2 months", Duration{Years: 1, Months: 2, Negative: true}},
+		{"5 minutes", Duration{Minutes: 5}},
+	}
+	for _, c := range cases {
+		var got, err = ParseHumanized(c.s)
+		if err != nil {
+			t.Fatalf("ParseHumanized(%q) error: %v", c.s, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseHumanized(%q) = %+v, want %+v", c.s, got, c.want)
+		}
+	}
+}
+
+func TestParseHumanizedInvalid(t *testing.T) {
+	var _, err = ParseHumanized("not a duration")
+	if err == nil {
+		t.Fatal("expected error for invalid humanized duration")
+	}
+}
+
+func TestDurationHumanizeLocale(t *testing.T) {
+	var d = Duration{Years: 1, Months: 2}
+	var cases = []struct {
+		locale language.Tag
+		want   string
+	}{
+		{language.English, "1 year 2 months"},
+		{language.Spanish, "1 año 2 meses"},
+		{language.Chinese, "1年2个月"},
+		{language.French, "1 year 2 months"}, // unrecognized locale falls back to English
+	}
+	for _, c := range cases {
+		var got = d.Humanize(WithLocale(c.locale))
+		if got != c.want {
+			t.Errorf("Humanize(WithLocale(%v)) = %q, want %q", c.locale, got, c.want)
+		}
+	}
+}
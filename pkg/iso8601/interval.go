@@ -0,0 +1,98 @@
+package iso8601
+
+import (
+	"strings"
+	"time"
+)
+
+// Interval contains iso8601 time interval data.
+// https://en.wikipedia.org/wiki/ISO_8601#Time_intervals
+//
+// Start and End are always resolved to concrete times, and Duration is
+// always populated too, regardless of which of the three forms the
+// interval was parsed from.
+type Interval struct {
+	Start    time.Time
+	End      time.Time
+	Duration Duration
+}
+
+// ErrInvalidInterval returned when parse failed.
+type ErrInvalidInterval struct {
+	String string
+}
+
+func (err ErrInvalidInterval) Error() string {
+	return "iso8601: invalid interval " + err.String
+}
+
+// ParseInterval parses an ISO 8601 time interval: <start>/<end>,
+// <start>/<duration>, or <duration>/<end>. The endpoint that is not given
+// directly is resolved via the calendar-aware Duration.AddTo /
+// Duration.SubtractFrom.
+func ParseInterval(s string) (ret Interval, err error) {
+	orig := s
+	var parts = strings.Split(s, "/")
+	if len(parts) != 2 {
+		return ret, ErrInvalidInterval{String: orig}
+	}
+
+	switch {
+	case isDurationString(parts[0]) && !isDurationString(parts[1]):
+		ret.Duration, err = ParseDuration(parts[0])
+		if err == nil {
+			ret.End, err = parseRecurrenceTime(parts[1])
+		}
+		if err == nil {
+			ret.Start = ret.Duration.SubtractFrom(ret.End)
+		}
+	case !isDurationString(parts[0]) && isDurationString(parts[1]):
+		ret.Start, err = parseRecurrenceTime(parts[0])
+		if err == nil {
+			ret.Duration, err = ParseDuration(parts[1])
+		}
+		if err == nil {
+			ret.End = ret.Duration.AddTo(ret.Start)
+		}
+	case !isDurationString(parts[0]) && !isDurationString(parts[1]):
+		ret.Start, err = parseRecurrenceTime(parts[0])
+		if err == nil {
+			ret.End, err = parseRecurrenceTime(parts[1])
+		}
+		if err == nil {
+			ret.Duration = *NewDuration(ret.End.Sub(ret.Start).Nanoseconds())
+		}
+	default:
+		err = ErrInvalidInterval{String: orig}
+	}
+	if err != nil {
+		return Interval{}, ErrInvalidInterval{String: orig}
+	}
+	return ret, nil
+}
+
+// AppendFormat is like String but appends the textual representation to b
+// and returns the extended buffer.
+func (iv Interval) AppendFormat(b []byte) []byte {
+	b = append(b, iv.Start.Format(time.RFC3339)...)
+	b = append(b, '/')
+	b = append(b, iv.End.Format(time.RFC3339)...)
+	return b
+}
+
+func (iv Interval) String() string {
+	return string(iv.AppendFormat(make([]byte, 0, 64)))
+}
+
+// Contains reports whether t falls within the interval, inclusive of both
+// endpoints.
+func (iv Interval) Contains(t time.Time) bool {
+	return !t.Before(iv.Start) && !t.After(iv.End)
+}
+
+// Overlaps reports whether iv and other share any instant other than a
+// common endpoint: intervals that only touch (one's End equal to the
+// other's Start) do not overlap.
+func (iv Interval) Overlaps(other Interval) bool {
+	return iv.Start.Before(other.End) && other.Start.Before(iv.End)
+}
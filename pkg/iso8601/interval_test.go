@@ -0,0 +1,59 @@
+package iso8601
+
+import "testing"
+
+func TestParseIntervalStartEnd(t *testing.T) {
+	var iv, err = ParseInterval("2007-03-01T13:00:00Z/2008-05-11T15:30:00Z")
+	if err != nil {
+		t.Fatalf("ParseInterval error: %v", err)
+	}
+	var wantStart = mustParseTime(t, "2007-03-01T13:00:00Z")
+	var wantEnd = mustParseTime(t, "2008-05-11T15:30:00Z")
+	if !iv.Start.Equal(wantStart) || !iv.End.Equal(wantEnd) {
+		t.Errorf("Start/End = %v/%v, want %v/%v", iv.Start, iv.End, wantStart, wantEnd)
+	}
+}
+
+func TestParseIntervalStartDuration(t *testing.T) {
+	var iv, err = ParseInterval("2007-03-01T13:00:00Z/P1Y")
+	if err != nil {
+		t.Fatalf("ParseInterval error: %v", err)
+	}
+	var wantEnd = mustParseTime(t, "2008-03-01T13:00:00Z")
+	if !iv.End.Equal(wantEnd) {
+		t.Errorf("End = %v, want %v", iv.End, wantEnd)
+	}
+}
+
+func TestParseIntervalDurationEnd(t *testing.T) {
+	var iv, err = ParseInterval("P1Y/2008-03-01T13:00:00Z")
+	if err != nil {
+		t.Fatalf("ParseInterval error: %v", err)
+	}
+	var wantStart = mustParseTime(t, "2007-03-01T13:00:00Z")
+	if !iv.Start.Equal(wantStart) {
+		t.Errorf("Start = %v, want %v", iv.Start, wantStart)
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	var iv, _ = ParseInterval("2007-03-01T00:00:00Z/2008-03-01T00:00:00Z")
+	if !iv.Contains(mustParseTime(t, "2007-06-01T00:00:00Z")) {
+		t.Error("expected interval to contain midpoint")
+	}
+	if iv.Contains(mustParseTime(t, "2009-01-01T00:00:00Z")) {
+		t.Error("expected interval not to contain time after end")
+	}
+}
+
+func TestIntervalOverlaps(t *testing.T) {
+	var a, _ = ParseInterval("2007-01-01T00:00:00Z/2007-06-01T00:00:00Z")
+	var b, _ = ParseInterval("2007-05-01T00:00:00Z/2007-12-01T00:00:00Z")
+	var c, _ = ParseInterval("2008-01-01T00:00:00Z/2008-06-01T00:00:00Z")
+	if !a.Overlaps(b) {
+		t.Error("expected a and b to overlap")
+	}
+	if a.Overlaps(c) {
+		t.Error("expected a and c not to overlap")
+	}
+}
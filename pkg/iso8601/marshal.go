@@ -0,0 +1,115 @@
+package iso8601
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return d.AppendFormat(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	var v, err = ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the duration as its ISO
+// 8601 string representation.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	var b = make([]byte, 0, 32)
+	b = append(b, '"')
+	b = d.AppendFormat(b)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("iso8601: invalid duration JSON " + string(data))
+	}
+	var v, err = ParseDuration(string(data[1 : len(data)-1]))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Value implements database/sql/driver.Valuer, storing the duration as its
+// ISO 8601 string representation.
+func (d Duration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements database/sql.Scanner. It accepts an ISO 8601 string (or
+// []byte), or an integer number of nanoseconds, converted with NewDuration.
+func (d *Duration) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Duration{}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	case int64:
+		*d = *NewDuration(v)
+		return nil
+	default:
+		return fmt.Errorf("iso8601: cannot scan %T into Duration", src)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the nine
+// fields (Years, Months, Weeks, Days, Hours, Minutes, Seconds, Nanoseconds,
+// Negative) as varints for compact wire transport.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	var buf = make([]byte, 0, 8*binary.MaxVarintLen64+1)
+	var tmp [binary.MaxVarintLen64]byte
+	for _, v := range [...]int64{
+		d.Years, d.Months, d.Weeks, d.Days,
+		d.Hours, d.Minutes, d.Seconds, d.Nanoseconds,
+	} {
+		var n = binary.PutVarint(tmp[:], v)
+		buf = append(buf, tmp[:n]...)
+	}
+	if d.Negative {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Duration) UnmarshalBinary(data []byte) error {
+	var fields [8]int64
+	for i := range fields {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			return errors.New("iso8601: invalid binary duration")
+		}
+		fields[i] = v
+		data = data[n:]
+	}
+	if len(data) != 1 {
+		return errors.New("iso8601: invalid binary duration")
+	}
+	d.Years, d.Months, d.Weeks, d.Days = fields[0], fields[1], fields[2], fields[3]
+	d.Hours, d.Minutes, d.Seconds, d.Nanoseconds = fields[4], fields[5], fields[6], fields[7]
+	d.Negative = data[0] != 0
+	return nil
+}
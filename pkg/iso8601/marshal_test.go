@@ -0,0 +1,87 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDurationTextMarshalRoundTrip(t *testing.T) {
+	var d = Duration{Years: 1, Months: 2, Days: 3}
+	var text, err = d.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	var got Duration
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if got != d {
+		t.Errorf("round trip = %+v, want %+v", got, d)
+	}
+}
+
+func TestDurationJSONRoundTrip(t *testing.T) {
+	type wrapper struct {
+		D Duration `json:"d"`
+	}
+	var w = wrapper{D: Duration{Hours: 2, Minutes: 30}}
+	var data, err = json.Marshal(w)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	var got wrapper
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if got.D != w.D {
+		t.Errorf("round trip = %+v, want %+v", got.D, w.D)
+	}
+}
+
+func TestDurationJSONNull(t *testing.T) {
+	var d = Duration{Hours: 1}
+	if err := d.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatalf("UnmarshalJSON(null) error: %v", err)
+	}
+	if d.Hours != 1 {
+		t.Errorf("UnmarshalJSON(null) modified d to %+v", d)
+	}
+}
+
+func TestDurationScanValue(t *testing.T) {
+	var d = Duration{Years: 1, Days: 2}
+	var v, err = d.Value()
+	if err != nil {
+		t.Fatalf("Value error: %v", err)
+	}
+	var got Duration
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan(%v) error: %v", v, err)
+	}
+	if got != d {
+		t.Errorf("Scan round trip = %+v, want %+v", got, d)
+	}
+
+	var fromInt Duration
+	if err := fromInt.Scan(int64(90000000000)); err != nil {
+		t.Fatalf("Scan(int64) error: %v", err)
+	}
+	if fromInt.Minutes != 1 || fromInt.Seconds != 30 {
+		t.Errorf("Scan(int64) = %+v, want 1m30s", fromInt)
+	}
+}
+
+func TestDurationBinaryRoundTrip(t *testing.T) {
+	var d = Duration{Years: 1, Months: 2, Weeks: 3, Days: 4, Hours: 5, Minutes: 6, Seconds: 7, Nanoseconds: 8, Negative: true}
+	var data, err = d.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary error: %v", err)
+	}
+	var got Duration
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary error: %v", err)
+	}
+	if got != d {
+		t.Errorf("round trip = %+v, want %+v", got, d)
+	}
+}
@@ -0,0 +1,171 @@
+package iso8601
+
+import "time"
+
+// Normalizer configures how Duration.Normalize (and Compare) carry
+// between fields. The zero Normalizer only carries Nanoseconds into
+// Seconds, which is always calendar-safe.
+type Normalizer struct {
+	// CarrySecondsToMinutes also carries Seconds into Minutes, Minutes
+	// into Hours, and Hours into Days.
+	CarrySecondsToMinutes bool
+	// CarryDaysToMonths carries Days (and Weeks, if WeeksToDays is not
+	// already folding them in) into Months and Years. Since month and
+	// year lengths vary, this requires AnchorDate.
+	CarryDaysToMonths bool
+	// WeeksToDays folds Weeks into Days before any other carry.
+	WeeksToDays bool
+	// AnchorDate is the reference instant CarryDaysToMonths measures
+	// calendar months/years from. Required for CarryDaysToMonths; ignored
+	// otherwise.
+	AnchorDate time.Time
+}
+
+// Normalize brings d into canonical form: Seconds, Minutes and Hours
+// within their natural ranges (and, with AnchorDate set, Days within the
+// anchored month's range), per the Normalizer's configuration. It returns
+// ErrOverflow if a carry would overflow int64.
+func (n Normalizer) Normalize(d Duration) (Duration, error) {
+	var ret = d
+	var err error
+
+	if carry := ret.Nanoseconds / int64(time.Second); carry != 0 {
+		ret.Seconds, err = addInt(ret.Seconds, carry)
+		if err != nil {
+			return Duration{}, err
+		}
+		ret.Nanoseconds -= carry * int64(time.Second)
+	}
+	if ret.Nanoseconds < 0 {
+		ret.Nanoseconds += int64(time.Second)
+		ret.Seconds--
+	}
+
+	if n.WeeksToDays {
+		var weekDays, mulErr = multiplyInt(7, ret.Weeks)
+		if mulErr != nil {
+			return Duration{}, mulErr
+		}
+		ret.Days, err = addInt(ret.Days, weekDays)
+		if err != nil {
+			return Duration{}, err
+		}
+		ret.Weeks = 0
+	}
+
+	if n.CarrySecondsToMinutes {
+		ret.Minutes, ret.Seconds, err = carry60(ret.Minutes, ret.Seconds)
+		if err != nil {
+			return Duration{}, err
+		}
+		ret.Hours, ret.Minutes, err = carry60(ret.Hours, ret.Minutes)
+		if err != nil {
+			return Duration{}, err
+		}
+		ret.Days, ret.Hours, err = carryN(ret.Days, ret.Hours, 24)
+		if err != nil {
+			return Duration{}, err
+		}
+	}
+
+	if n.CarryDaysToMonths && !n.AnchorDate.IsZero() {
+		var totalDays = ret.Days
+		if !n.WeeksToDays {
+			var weekDays, mulErr = multiplyInt(7, ret.Weeks)
+			if mulErr != nil {
+				return Duration{}, mulErr
+			}
+			totalDays, err = addInt(totalDays, weekDays)
+			if err != nil {
+				return Duration{}, err
+			}
+		}
+		var target = n.AnchorDate.AddDate(int(ret.Years), int(ret.Months), int(totalDays))
+		var years, months, days = calendarDiff(n.AnchorDate, target)
+		ret.Years, ret.Months, ret.Days = int64(years), int64(months), int64(days)
+		ret.Weeks = 0
+	}
+
+	return ret, nil
+}
+
+// carry60 folds excess units of 60 from low into high (e.g. Seconds into
+// Minutes), returning the updated (high, low) pair.
+func carry60(high, low int64) (int64, int64, error) {
+	return carryN(high, low, 60)
+}
+
+// carryN folds excess units of size n from low into high, returning the
+// updated (high, low) pair with 0 <= low < n. It returns ErrOverflow if
+// the carry into high would overflow int64.
+func carryN(high, low, n int64) (newHigh, newLow int64, err error) {
+	var carry = low / n
+	low -= carry * n
+	high, err = addInt(high, carry)
+	if err != nil {
+		return 0, 0, err
+	}
+	if low < 0 {
+		low += n
+		high, err = addInt(high, -1)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return high, low, nil
+}
+
+// calendarDiff returns the calendar years/months/days elapsed from a to b,
+// assuming b is not before a.
+func calendarDiff(a, b time.Time) (years, months, days int) {
+	var y1, m1, d1 = a.Date()
+	var y2, m2, d2 = b.Date()
+	years = y2 - y1
+	months = int(m2) - int(m1)
+	days = d2 - d1
+	if days < 0 {
+		months--
+		days += time.Date(y2, m2, 0, 0, 0, 0, 0, b.Location()).Day()
+	}
+	if months < 0 {
+		years--
+		months += 12
+	}
+	return
+}
+
+// Normalize brings d into canonical form using the default rules: carry
+// Nanoseconds up through Seconds, Minutes and Hours into Days (Days itself
+// is not carried further), but leave Weeks, Months and
+// Years untouched since those require an anchor date to carry correctly.
+// Use a Normalizer directly for calendar-accurate Days<->Months carrying.
+// It returns ErrOverflow if a carry would overflow int64.
+func (d Duration) Normalize() (Duration, error) {
+	return Normalizer{CarrySecondsToMinutes: true}.Normalize(d)
+}
+
+// MustNormalize is like Normalize but panics instead of returning an
+// error.
+func (d Duration) MustNormalize() Duration {
+	var ret, err = d.Normalize()
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Compare returns -1, 0 or 1 depending on whether a is less than, equal
+// to, or greater than b, resolving ambiguous fields (e.g. "P1M" vs "P30D")
+// by applying both durations to anchor and comparing the resulting
+// instants.
+func Compare(a, b Duration, anchor time.Time) int {
+	var ta, tb = a.AddTo(anchor), b.AddTo(anchor)
+	switch {
+	case ta.Before(tb):
+		return -1
+	case ta.After(tb):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,58 @@
+package iso8601
+
+import "testing"
+
+func TestDurationNormalizeDefault(t *testing.T) {
+	var d = Duration{Seconds: 125, Nanoseconds: int64(1500000000)}
+	var got, err = d.Normalize()
+	if err != nil {
+		t.Fatalf("Normalize error: %v", err)
+	}
+	// 125s + 1.5s = 126.5s -> 2m 6.5s
+	if got.Minutes != 2 || got.Seconds != 6 || got.Nanoseconds != 500000000 {
+		t.Errorf("Normalize() = %+v, want Minutes=2 Seconds=6 Nanoseconds=5e8", got)
+	}
+}
+
+func TestNormalizerCarryDaysToMonthsIncludesWeeks(t *testing.T) {
+	var anchor = mustParseTime(t, "2024-01-01T00:00:00Z")
+	var n = Normalizer{CarryDaysToMonths: true, AnchorDate: anchor}
+	var got, err = n.Normalize(Duration{Weeks: 5})
+	if err != nil {
+		t.Fatalf("Normalize error: %v", err)
+	}
+	if got == (Duration{Weeks: 5}) {
+		t.Error("Normalize did not carry Weeks into Months/Years despite CarryDaysToMonths")
+	}
+	if got.Weeks != 0 {
+		t.Errorf("Weeks = %d, want 0 after carry", got.Weeks)
+	}
+	// 5 weeks = 35 days from 2024-01-01 -> 2024-02-05, i.e. 1 month 4 days.
+	if got.Months != 1 || got.Days != 4 {
+		t.Errorf("Months/Days = %d/%d, want 1/4", got.Months, got.Days)
+	}
+}
+
+func TestDurationMustNormalizePanicsOnOverflow(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected MustNormalize to panic on overflow")
+		}
+	}()
+	Duration{Hours: maxInt64, Minutes: 60}.MustNormalize()
+}
+
+func TestCompare(t *testing.T) {
+	var anchor = mustParseTime(t, "2024-02-01T00:00:00Z") // 2024 is a leap year, Feb has 29 days
+	var oneMonth = Duration{Months: 1}
+	var thirtyDays = Duration{Days: 30}
+	if Compare(oneMonth, thirtyDays, anchor) != -1 {
+		t.Error("expected P1M < P30D anchored at Feb 2024 (29-day month)")
+	}
+	if Compare(thirtyDays, oneMonth, anchor) != 1 {
+		t.Error("expected P30D > P1M anchored at Feb 2024 (29-day month)")
+	}
+	if Compare(oneMonth, oneMonth, anchor) != 0 {
+		t.Error("expected equal durations to compare equal")
+	}
+}
@@ -0,0 +1,170 @@
+package iso8601
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence contains iso8601 repeating interval data.
+// https://en.wikipedia.org/wiki/ISO_8601#Repeating_intervals
+type Recurrence struct {
+	// Count is the number of repetitions, or -1 for unbounded (R/...).
+	Count int
+	// Start is the recurrence start time, zero if the source form did not
+	// specify one (duration/end form).
+	Start time.Time
+	// End is the recurrence end time, zero if the source form did not
+	// specify one (start/duration form).
+	End time.Time
+	// Duration is the interval between occurrences.
+	Duration Duration
+
+	emitted int
+}
+
+// ErrInvalidRecurrence returned when parse failed.
+type ErrInvalidRecurrence struct {
+	String string
+}
+
+func (err ErrInvalidRecurrence) Error() string {
+	return "iso8601: invalid recurrence " + err.String
+}
+
+// recurrenceTimeLayouts are tried in order when parsing the start/end
+// component of a repeating interval, which may or may not carry a time of
+// day.
+var recurrenceTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02",
+}
+
+func parseRecurrenceTime(s string) (time.Time, error) {
+	for _, layout := range recurrenceTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("iso8601: invalid recurrence time " + s)
+}
+
+func isDurationString(s string) bool {
+	return strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") || strings.HasPrefix(s, "+P")
+}
+
+// ParseRecurrence parses an ISO 8601 repeating interval:
+// R[n]/<start>/<duration>, R[n]/<duration>/<end>, R[n]/<start>/<end>, or
+// R[n]/<duration>. n is omitted for an unbounded recurrence (R/...), in
+// which case Count is -1.
+func ParseRecurrence(s string) (ret Recurrence, err error) {
+	orig := s
+	if s == "" || s[0] != 'R' {
+		err = ErrInvalidRecurrence{String: orig}
+		return
+	}
+	s = s[1:]
+
+	i := strings.IndexByte(s, '/')
+	if i < 0 {
+		err = ErrInvalidRecurrence{String: orig}
+		return
+	}
+	ret.Count = -1
+	if i > 0 {
+		var n int64
+		n, err = strconv.ParseInt(s[:i], 10, 64)
+		if err != nil || n < 0 {
+			err = ErrInvalidRecurrence{String: orig}
+			return
+		}
+		ret.Count = int(n)
+	}
+	s = s[i+1:]
+
+	var parts = strings.Split(s, "/")
+	switch len(parts) {
+	case 1:
+		ret.Duration, err = ParseDuration(parts[0])
+	case 2:
+		switch {
+		case isDurationString(parts[0]) && !isDurationString(parts[1]):
+			ret.Duration, err = ParseDuration(parts[0])
+			if err == nil {
+				ret.End, err = parseRecurrenceTime(parts[1])
+			}
+		case !isDurationString(parts[0]) && isDurationString(parts[1]):
+			ret.Start, err = parseRecurrenceTime(parts[0])
+			if err == nil {
+				ret.Duration, err = ParseDuration(parts[1])
+			}
+		case !isDurationString(parts[0]) && !isDurationString(parts[1]):
+			ret.Start, err = parseRecurrenceTime(parts[0])
+			if err == nil {
+				ret.End, err = parseRecurrenceTime(parts[1])
+			}
+			if err == nil {
+				ret.Duration = *NewDuration(ret.End.Sub(ret.Start).Nanoseconds())
+			}
+		default:
+			err = ErrInvalidRecurrence{String: orig}
+		}
+	default:
+		err = ErrInvalidRecurrence{String: orig}
+	}
+	if err != nil {
+		ret = Recurrence{}
+		err = ErrInvalidRecurrence{String: orig}
+		return
+	}
+	return
+}
+
+// AppendFormat is like String but appends the textual representation to b
+// and returns the extended buffer.
+func (r Recurrence) AppendFormat(b []byte) []byte {
+	b = append(b, 'R')
+	if r.Count >= 0 {
+		b = strconv.AppendInt(b, int64(r.Count), 10)
+	}
+	b = append(b, '/')
+	switch {
+	case !r.Start.IsZero() && !r.End.IsZero():
+		b = append(b, r.Start.Format(time.RFC3339)...)
+		b = append(b, '/')
+		b = append(b, r.End.Format(time.RFC3339)...)
+	case !r.Start.IsZero():
+		b = append(b, r.Start.Format(time.RFC3339)...)
+		b = append(b, '/')
+		b = r.Duration.AppendFormat(b)
+	case !r.End.IsZero():
+		b = r.Duration.AppendFormat(b)
+		b = append(b, '/')
+		b = append(b, r.End.Format(time.RFC3339)...)
+	default:
+		b = r.Duration.AppendFormat(b)
+	}
+	return b
+}
+
+func (r Recurrence) String() string {
+	return string(r.AppendFormat(make([]byte, 0, 64)))
+}
+
+// Next advances prev by Duration using calendar-aware semantics and
+// reports whether that occurrence falls within this recurrence: it stops
+// once Count occurrences have been emitted (unless Count is -1), or once
+// End is reached.
+func (r *Recurrence) Next(prev time.Time) (time.Time, bool) {
+	if r.Count >= 0 && r.emitted >= r.Count {
+		return time.Time{}, false
+	}
+	var next = r.Duration.AddTo(prev)
+	if !r.End.IsZero() && next.After(r.End) {
+		return time.Time{}, false
+	}
+	r.emitted++
+	return next, true
+}
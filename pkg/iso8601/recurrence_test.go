@@ -0,0 +1,52 @@
+package iso8601
+
+import "testing"
+
+func TestParseRecurrence(t *testing.T) {
+	var r, err = ParseRecurrence("R3/2008-03-01T13:00:00Z/P1Y2M10DT2H30M")
+	if err != nil {
+		t.Fatalf("ParseRecurrence error: %v", err)
+	}
+	if r.Count != 3 {
+		t.Errorf("Count = %d, want 3", r.Count)
+	}
+	if r.Duration.Years != 1 || r.Duration.Months != 2 || r.Duration.Days != 10 {
+		t.Errorf("Duration = %+v, unexpected", r.Duration)
+	}
+}
+
+func TestParseRecurrenceUnbounded(t *testing.T) {
+	var r, err = ParseRecurrence("R/2008-03-01T13:00:00Z/P1Y")
+	if err != nil {
+		t.Fatalf("ParseRecurrence error: %v", err)
+	}
+	if r.Count != -1 {
+		t.Errorf("Count = %d, want -1", r.Count)
+	}
+}
+
+func TestParseRecurrenceRejectsNegativeCount(t *testing.T) {
+	var _, err = ParseRecurrence("R-5/2008-03-01T00:00:00Z/P1Y")
+	if err == nil {
+		t.Fatal("expected error for negative recurrence count")
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	var r, err = ParseRecurrence("R2/2008-03-01T00:00:00Z/P1Y")
+	if err != nil {
+		t.Fatalf("ParseRecurrence error: %v", err)
+	}
+	var prev = r.Start
+	var got []bool
+	for i := 0; i < 3; i++ {
+		var next, ok = r.Next(prev)
+		got = append(got, ok)
+		if ok {
+			prev = next
+		}
+	}
+	if got[0] != true || got[1] != true || got[2] != false {
+		t.Errorf("Next sequence = %v, want [true true false]", got)
+	}
+}